@@ -0,0 +1,54 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build openbsd
+
+package runtime
+
+import "unsafe"
+
+// This file holds the sysctl(2) MIB helpers and the osinit lookups built on
+// top of them. It is built for OpenBSD only; other BSD-family ports do not
+// compile it. os_openbsd.go supplies the MIB constants (_CTL_HW, _HW_NCPU,
+// _HW_NCPUONLINE, _HW_PAGESIZE, _CTL_KERN, _KERN_OSREV) used below.
+
+// sysctlInt32 issues a sysctl(2) call for mib and interprets the result as
+// a 32-bit integer, such as hw.ncpu or kern.osrevision.
+func sysctlInt32(mib []uint32) (int32, bool) {
+	var out int32
+	nout := unsafe.Sizeof(out)
+	ret := sysctl(&mib[0], uint32(len(mib)), (*byte)(unsafe.Pointer(&out)), &nout, nil, 0)
+	if ret < 0 {
+		return 0, false
+	}
+	return out, true
+}
+
+func getncpu() int32 {
+	// Try hw.ncpuonline first: on OpenBSD 6.4, hw.ncpu reports a number
+	// twice as high as the actual CPUs running with hyperthreading disabled
+	// (hw.smt=0). See https://golang.org/issue/30127. If the hw.ncpuonline
+	// lookup fails, hw.ncpu is tried next.
+	if n, ok := sysctlInt32([]uint32{_CTL_HW, _HW_NCPUONLINE}); ok {
+		return n
+	}
+	if n, ok := sysctlInt32([]uint32{_CTL_HW, _HW_NCPU}); ok {
+		return n
+	}
+	return 1
+}
+
+func getPageSize() uintptr {
+	if ps, ok := sysctlInt32([]uint32{_CTL_HW, _HW_PAGESIZE}); ok {
+		return uintptr(ps)
+	}
+	return 0
+}
+
+func getOSRev() int {
+	if osrev, ok := sysctlInt32([]uint32{_CTL_KERN, _KERN_OSREV}); ok {
+		return int(osrev)
+	}
+	return 0
+}
@@ -41,43 +41,6 @@ const (
 	_HW_NCPUONLINE = 25
 )
 
-func sysctlInt(mib []uint32) (int32, bool) {
-	var out int32
-	nout := unsafe.Sizeof(out)
-	ret := sysctl(&mib[0], uint32(len(mib)), (*byte)(unsafe.Pointer(&out)), &nout, nil, 0)
-	if ret < 0 {
-		return 0, false
-	}
-	return out, true
-}
-
-func getncpu() int32 {
-	// Try hw.ncpuonline first because hw.ncpu would report a number twice as
-	// high as the actual CPUs running on OpenBSD 6.4 with hyperthreading
-	// disabled (hw.smt=0). See https://golang.org/issue/30127
-	if n, ok := sysctlInt([]uint32{_CTL_HW, _HW_NCPUONLINE}); ok {
-		return int32(n)
-	}
-	if n, ok := sysctlInt([]uint32{_CTL_HW, _HW_NCPU}); ok {
-		return int32(n)
-	}
-	return 1
-}
-
-func getPageSize() uintptr {
-	if ps, ok := sysctlInt([]uint32{_CTL_HW, _HW_PAGESIZE}); ok {
-		return uintptr(ps)
-	}
-	return 0
-}
-
-func getOSRev() int {
-	if osrev, ok := sysctlInt([]uint32{_CTL_KERN, _KERN_OSREV}); ok {
-		return int(osrev)
-	}
-	return 0
-}
-
 //go:nosplit
 func semacreate(mp *m) {
 }